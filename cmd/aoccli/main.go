@@ -7,6 +7,7 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 
+	"github.com/LakshyaMittal3301/aoccli/internal/cache"
 	"github.com/LakshyaMittal3301/aoccli/internal/config"
 	"github.com/LakshyaMittal3301/aoccli/internal/tui"
 )
@@ -14,10 +15,14 @@ import (
 func main() {
 	reset := false
 	help := false
+	refresh := cache.DefaultTTL
+	cacheTTL := cache.DefaultTTL
 
 	fs := flag.NewFlagSet("aoccli", flag.ExitOnError)
 	fs.BoolVar(&reset, "reset-config", false, "delete the saved config file and exit")
 	fs.BoolVar(&help, "help", false, "show help")
+	fs.DurationVar(&refresh, "refresh", cache.DefaultTTL, "auto-refresh interval while viewing a leaderboard (0 disables)")
+	fs.DurationVar(&cacheTTL, "cache-ttl", cache.DefaultTTL, "how long a cached leaderboard fetch stays fresh")
 	fs.Usage = func() {
 		fmt.Fprintf(fs.Output(), "Usage: %s [flags]\n\nFlags:\n", os.Args[0])
 		fs.PrintDefaults()
@@ -42,7 +47,7 @@ func main() {
 	}
 
 	cfg, err := config.Load()
-	m := tui.New(cfg, err)
+	m := tui.New(cfg, err, refresh, cacheTTL)
 
 	p := tea.NewProgram(m, tea.WithAltScreen())
 	if _, err := p.Run(); err != nil {
@@ -4,9 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"regexp"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -54,6 +58,12 @@ type DayEntry struct {
 	Pos        int           // rank position (AoC-style, ties share rank)
 }
 
+// PrivateLeaderboardURL builds the read-only JSON URL for a private
+// leaderboard from its event (year), board ID, and view key.
+func PrivateLeaderboardURL(event, boardID, viewKey string) string {
+	return fmt.Sprintf("https://adventofcode.com/%s/leaderboard/private/view/%s.json?view_key=%s", event, boardID, viewKey)
+}
+
 // FetchLeaderboard retrieves and decodes AoC JSON from the given URL.
 func FetchLeaderboard(ctx context.Context, url string) (*Leaderboard, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
@@ -61,6 +71,25 @@ func FetchLeaderboard(ctx context.Context, url string) (*Leaderboard, error) {
 		return nil, err
 	}
 
+	return doFetchLeaderboard(req)
+}
+
+// FetchLeaderboardWithSession retrieves the private leaderboard JSON for the
+// given event (year) and board ID using an authenticated session cookie,
+// instead of the board's view key. This lets a board's owner (or any member
+// who has logged in) fetch boards without needing a share link.
+func FetchLeaderboardWithSession(ctx context.Context, event, boardID, sessionCookie string) (*Leaderboard, error) {
+	url := fmt.Sprintf("https://adventofcode.com/%s/leaderboard/private/view/%s.json", event, boardID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.AddCookie(&http.Cookie{Name: "session", Value: sessionCookie})
+
+	return doFetchLeaderboard(req)
+}
+
+func doFetchLeaderboard(req *http.Request) (*Leaderboard, error) {
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return nil, err
@@ -78,6 +107,130 @@ func FetchLeaderboard(ctx context.Context, url string) (*Leaderboard, error) {
 	return &lb, nil
 }
 
+// SubmitResult is the outcome of submitting a puzzle answer.
+type SubmitResult int
+
+const (
+	SubmitUnknown SubmitResult = iota
+	SubmitCorrect
+	SubmitIncorrect
+	SubmitWait
+	SubmitAlreadyDone
+	SubmitWrongLevel
+)
+
+func (r SubmitResult) String() string {
+	switch r {
+	case SubmitCorrect:
+		return "correct"
+	case SubmitIncorrect:
+		return "incorrect"
+	case SubmitWait:
+		return "wait"
+	case SubmitAlreadyDone:
+		return "already done"
+	case SubmitWrongLevel:
+		return "wrong level"
+	default:
+		return "unknown"
+	}
+}
+
+// SubmitOutcome is the parsed result of a Submit call. Cooldown is set when
+// Result is SubmitWait and AoC hinted how long to wait before trying again.
+type SubmitOutcome struct {
+	Result   SubmitResult
+	Cooldown time.Duration
+}
+
+// waitClockRe matches AoC's "Xm Ys left to wait" / "...before trying again"
+// cooldown hint. It's anchored on the minutes digit itself (mandatory)
+// rather than on the trailing keyword alone, since that keyword also
+// appears earlier in the same response with no figure in front of it.
+var waitClockRe = regexp.MustCompile(`(\d+)\s*m(?:\s*(\d+)\s*s)?\s*(?:left to wait|before trying again)`)
+
+// waitMinutesRe matches AoC's plainer "please wait N minutes" cooldown hint.
+var waitMinutesRe = regexp.MustCompile(`please wait (\d+)\s*minutes?`)
+
+// Submit posts an answer for the given year/day/part using an authenticated
+// session cookie and returns the parsed outcome. alreadyCompleted should
+// reflect whether the caller already knows this day/part was solved before
+// this submission (e.g. from a cached Leaderboard); it's only consulted to
+// disambiguate AoC's ambiguous "wrong level" response (see
+// parseSubmitOutcome).
+func Submit(ctx context.Context, sessionCookie string, year, day, part int, answer string, alreadyCompleted bool) (SubmitOutcome, error) {
+	endpoint := fmt.Sprintf("https://adventofcode.com/%d/day/%d/answer", year, day)
+
+	form := url.Values{}
+	form.Set("level", strconv.Itoa(part))
+	form.Set("answer", answer)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return SubmitOutcome{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.AddCookie(&http.Cookie{Name: "session", Value: sessionCookie})
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return SubmitOutcome{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return SubmitOutcome{}, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return SubmitOutcome{}, err
+	}
+
+	return parseSubmitOutcome(string(body), alreadyCompleted), nil
+}
+
+// parseSubmitOutcome looks for AoC's well-known response phrases in the
+// returned HTML page and maps them to a SubmitResult. AoC uses the same
+// sentence ("You don't seem to be solving the right level. Did you already
+// complete it?") for both "you already solved this exact level" and "you're
+// submitting the wrong level" (e.g. part 2 before part 1); alreadyCompleted
+// disambiguates using what the caller already knows about this day/part.
+func parseSubmitOutcome(html string, alreadyCompleted bool) SubmitOutcome {
+	lower := strings.ToLower(html)
+	switch {
+	case strings.Contains(lower, "that's the right answer"):
+		return SubmitOutcome{Result: SubmitCorrect}
+	case strings.Contains(lower, "you gave an answer too recently"):
+		return SubmitOutcome{Result: SubmitWait, Cooldown: parseWaitDuration(lower)}
+	case strings.Contains(lower, "don't seem to be solving the right level"):
+		if alreadyCompleted {
+			return SubmitOutcome{Result: SubmitAlreadyDone}
+		}
+		return SubmitOutcome{Result: SubmitWrongLevel}
+	case strings.Contains(lower, "not the right answer"):
+		return SubmitOutcome{Result: SubmitIncorrect}
+	default:
+		return SubmitOutcome{Result: SubmitUnknown}
+	}
+}
+
+// parseWaitDuration extracts a "please wait N minutes" / "Xm Ys left to
+// wait" hint from an AoC cooldown response (already lowercased). It returns
+// 0 if none is found.
+func parseWaitDuration(lower string) time.Duration {
+	if m := waitClockRe.FindStringSubmatch(lower); m != nil {
+		minutes, _ := strconv.Atoi(m[1])
+		seconds, _ := strconv.Atoi(m[2])
+		return time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	}
+	if m := waitMinutesRe.FindStringSubmatch(lower); m != nil {
+		minutes, _ := strconv.Atoi(m[1])
+		return time.Duration(minutes) * time.Minute
+	}
+	return 0
+}
+
 // MaxAvailableDay returns the highest day index for which at least one member has data.
 // It respects NumDays as an upper bound.
 func MaxAvailableDay(lb *Leaderboard) int {
@@ -249,3 +402,314 @@ func BuildDayEntries(lb *Leaderboard, day int) []DayEntry {
 
 	return entries
 }
+
+// OverallEntry is a member's season-wide standing: total score, stars, and a
+// compact calendar of which parts they've completed.
+type OverallEntry struct {
+	MemberID    string
+	Name        string
+	LocalScore  int
+	TotalStars  int
+	FirstStarTs int64 // 0 if no stars yet
+	LastStarTs  int64
+	StarMap     string // 25 cells, one of "..", "*.", "**" per day
+	Pos         int    // rank position (AoC-style, ties share rank)
+}
+
+// BuildOverall aggregates each member's season totals, sorted by local score
+// desc (ties broken by total stars, then name) with AoC-style shared ranks.
+func BuildOverall(lb *Leaderboard) []OverallEntry {
+	entries := make([]OverallEntry, 0, len(lb.Members))
+
+	for key, m := range lb.Members {
+		entries = append(entries, OverallEntry{
+			MemberID:    key,
+			Name:        m.DisplayName(),
+			LocalScore:  m.LocalScore,
+			TotalStars:  m.Stars,
+			FirstStarTs: firstStarTs(m),
+			LastStarTs:  m.LastStarTs,
+			StarMap:     starMap(m),
+		})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.LocalScore != b.LocalScore {
+			return a.LocalScore > b.LocalScore
+		}
+		if a.TotalStars != b.TotalStars {
+			return a.TotalStars > b.TotalStars
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.MemberID < b.MemberID
+	})
+
+	nextRank := 1
+	lastScore := -1
+	lastDisplay := 0
+	for i := range entries {
+		if i == 0 || entries[i].LocalScore != lastScore {
+			entries[i].Pos = nextRank
+			lastScore = entries[i].LocalScore
+			lastDisplay = entries[i].Pos
+		} else {
+			entries[i].Pos = lastDisplay
+		}
+		nextRank++
+	}
+
+	return entries
+}
+
+// firstStarTs returns the earliest get_star_ts across all of a member's
+// completions, or 0 if they have none.
+func firstStarTs(m Member) int64 {
+	var first int64
+	for _, dayData := range m.CompletionDayLevel {
+		for _, star := range dayData {
+			if first == 0 || star.GetStarTs < first {
+				first = star.GetStarTs
+			}
+		}
+	}
+	return first
+}
+
+// starMap renders a 25-cell calendar, one cell per day: ".." for no stars,
+// "*." for part 1 only, "**" for both parts.
+func starMap(m Member) string {
+	var b strings.Builder
+	for day := 1; day <= 25; day++ {
+		dayData := m.CompletionDayLevel[strconv.Itoa(day)]
+		switch {
+		case dayData["2"].GetStarTs != 0:
+			b.WriteString("**")
+		case dayData["1"].GetStarTs != 0:
+			b.WriteString("*.")
+		default:
+			b.WriteString("..")
+		}
+	}
+	return b.String()
+}
+
+// ChallengeReleaseTime returns the release time (00:00 EST / 05:00 UTC) for
+// a given year and day, independent of any particular leaderboard. Useful
+// for a countdown banner before a day unlocks.
+func ChallengeReleaseTime(year, day int) time.Time {
+	est := time.FixedZone("EST", -5*3600)
+	return time.Date(year, time.December, day, 0, 0, 0, 0, est)
+}
+
+// DailyLeaderboardEntry is one ranked solver on the public top-100 daily
+// leaderboard for a single part of a single day.
+type DailyLeaderboardEntry struct {
+	Rank  int
+	Name  string
+	Since time.Duration // elapsed time since release when this part was solved
+}
+
+// AbsoluteTime returns when this entry's star was earned, given the day's
+// release time (see ChallengeReleaseTime).
+func (e DailyLeaderboardEntry) AbsoluteTime(release time.Time) time.Time {
+	return release.Add(e.Since)
+}
+
+// DailyLeaderboard is the public top-100 leaderboard for one day, scraped
+// from https://adventofcode.com/<year>/leaderboard/day/<day>.
+type DailyLeaderboard struct {
+	Year  int
+	Day   int
+	Part1 []DailyLeaderboardEntry
+	Part2 []DailyLeaderboardEntry
+}
+
+// GlobalLeaderboardEntry is one ranked member of the season's global (top
+// 100 overall score) leaderboard.
+type GlobalLeaderboardEntry struct {
+	Rank  int
+	Name  string
+	Score int
+}
+
+// GlobalLeaderboard is the public top-100 overall leaderboard for a season,
+// scraped from https://adventofcode.com/<year>/leaderboard.
+type GlobalLeaderboard struct {
+	Year    int
+	Entries []GlobalLeaderboardEntry
+}
+
+// leaderboardEntryBlockRe isolates one "leaderboard-entry" row. Extracting
+// fields from within a single block (rather than matching across the whole
+// page with a lazy `.*?`) keeps a plain-text username — which has no tag of
+// its own to stop on — from running into the next row's markup.
+// This targets AoC's current HTML layout and, like any scraper, may need
+// updating if that markup changes.
+var leaderboardEntryBlockRe = regexp.MustCompile(`(?s)<div class="leaderboard-entry">(.*?)</div>`)
+
+var leaderboardPositionRe = regexp.MustCompile(`leaderboard-position">\s*(\d+)\)`)
+var leaderboardTimeRe = regexp.MustCompile(`leaderboard-time">([^<]+)`)
+var leaderboardScoreRe = regexp.MustCompile(`leaderboard-totalscore">\s*(\d+)`)
+var htmlTagRe = regexp.MustCompile(`<[^>]*>`)
+
+// entryName returns the name trailing after's match within block (the rest
+// of the entry, past any field regexes already consulted), with any
+// wrapping tag (e.g. a GitHub profile <a>) stripped.
+func entryName(block string, after *regexp.Regexp) string {
+	loc := after.FindStringIndex(block)
+	if loc == nil {
+		return ""
+	}
+	return strings.TrimSpace(htmlTagRe.ReplaceAllString(block[loc[1]:], ""))
+}
+
+// dailyPartHeaderRe finds the "--- Top 100 of Part One/Two ---" separators
+// that split a day's leaderboard page into its two part-specific tables.
+var dailyPartHeaderRe = regexp.MustCompile(`-+\s*Top 100 of Part (One|Two)\s*-+`)
+
+// FetchDailyLeaderboard scrapes the public top-100 leaderboard for a single
+// day, split into per-part rankings.
+func FetchDailyLeaderboard(ctx context.Context, year, day int) (*DailyLeaderboard, error) {
+	url := fmt.Sprintf("https://adventofcode.com/%d/leaderboard/day/%d", year, day)
+	body, err := fetchHTML(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	dl := &DailyLeaderboard{Year: year, Day: day}
+
+	sections := dailyPartHeaderRe.Split(body, -1)
+	headers := dailyPartHeaderRe.FindAllStringSubmatch(body, -1)
+	// sections[0] is whatever precedes the first header; sections[i+1]
+	// follows headers[i].
+	for i, h := range headers {
+		if i+1 >= len(sections) {
+			break
+		}
+		entries := parseDailyEntries(sections[i+1])
+		switch h[1] {
+		case "One":
+			dl.Part1 = entries
+		case "Two":
+			dl.Part2 = entries
+		}
+	}
+
+	return dl, nil
+}
+
+func parseDailyEntries(section string) []DailyLeaderboardEntry {
+	blocks := leaderboardEntryBlockRe.FindAllString(section, -1)
+	entries := make([]DailyLeaderboardEntry, 0, len(blocks))
+	for _, block := range blocks {
+		posM := leaderboardPositionRe.FindStringSubmatch(block)
+		timeM := leaderboardTimeRe.FindStringSubmatch(block)
+		if posM == nil || timeM == nil {
+			continue
+		}
+		rank, err := strconv.Atoi(posM[1])
+		if err != nil {
+			continue
+		}
+		since, err := parseClockDuration(strings.TrimSpace(timeM[1]))
+		if err != nil {
+			continue
+		}
+		name := entryName(block, leaderboardTimeRe)
+		if name == "" {
+			continue
+		}
+		entries = append(entries, DailyLeaderboardEntry{
+			Rank:  rank,
+			Name:  name,
+			Since: since,
+		})
+	}
+	return entries
+}
+
+// parseClockDuration parses an AoC "HH:MM:SS" elapsed-time string.
+func parseClockDuration(s string) (time.Duration, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("not an HH:MM:SS duration: %q", s)
+	}
+	h, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, err
+	}
+	m, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, err
+	}
+	sec, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + time.Duration(sec)*time.Second, nil
+}
+
+// FetchGlobalLeaderboard scrapes the public top-100 overall leaderboard for
+// a season.
+func FetchGlobalLeaderboard(ctx context.Context, year int) (*GlobalLeaderboard, error) {
+	url := fmt.Sprintf("https://adventofcode.com/%d/leaderboard", year)
+	body, err := fetchHTML(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := leaderboardEntryBlockRe.FindAllString(body, -1)
+	entries := make([]GlobalLeaderboardEntry, 0, len(blocks))
+	for _, block := range blocks {
+		posM := leaderboardPositionRe.FindStringSubmatch(block)
+		scoreM := leaderboardScoreRe.FindStringSubmatch(block)
+		if posM == nil || scoreM == nil {
+			continue
+		}
+		rank, err := strconv.Atoi(posM[1])
+		if err != nil {
+			continue
+		}
+		score, err := strconv.Atoi(scoreM[1])
+		if err != nil {
+			continue
+		}
+		name := entryName(block, leaderboardScoreRe)
+		if name == "" {
+			continue
+		}
+		entries = append(entries, GlobalLeaderboardEntry{
+			Rank:  rank,
+			Score: score,
+			Name:  name,
+		})
+	}
+
+	return &GlobalLeaderboard{Year: year, Entries: entries}, nil
+}
+
+func fetchHTML(ctx context.Context, url string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
@@ -0,0 +1,122 @@
+// Package cache persists fetched leaderboards to disk so the TUI can avoid
+// hammering AoC with requests while it's left open.
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/LakshyaMittal3301/aoccli/internal/aoc"
+)
+
+var ErrStale = errors.New("cache entry missing or stale")
+
+// DefaultTTL matches AoC's request-throttling guidance.
+const DefaultTTL = 15 * time.Minute
+
+// Dir returns the cache directory, e.g. ~/.cache/aoccli.
+func Dir() (string, error) {
+	if dir := os.Getenv("XDG_CACHE_HOME"); dir != "" {
+		return filepath.Join(dir, "aoccli"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("cannot determine home directory: %w", err)
+	}
+	return filepath.Join(home, ".cache", "aoccli"), nil
+}
+
+// jsonPath and tsPath return the leaderboard file and its timestamp sidecar
+// for a given event (year) + board ID.
+func jsonPath(event, boardID string) (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, fmt.Sprintf("%s-%s.json", event, boardID)), nil
+}
+
+func tsPath(event, boardID string) (string, error) {
+	p, err := jsonPath(event, boardID)
+	if err != nil {
+		return "", err
+	}
+	return p + ".ts", nil
+}
+
+// Load returns the cached leaderboard for event+boardID if it was fetched
+// within ttl, and ErrStale otherwise (including when there is no entry yet).
+func Load(event, boardID string, ttl time.Duration) (*aoc.Leaderboard, error) {
+	tp, err := tsPath(event, boardID)
+	if err != nil {
+		return nil, err
+	}
+	tsRaw, err := os.ReadFile(tp)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrStale
+	}
+	if err != nil {
+		return nil, err
+	}
+	fetchedUnix, err := strconv.ParseInt(strings.TrimSpace(string(tsRaw)), 10, 64)
+	if err != nil {
+		return nil, ErrStale
+	}
+	if time.Since(time.Unix(fetchedUnix, 0)) > ttl {
+		return nil, ErrStale
+	}
+
+	jp, err := jsonPath(event, boardID)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(jp)
+	if err != nil {
+		return nil, err
+	}
+
+	var lb aoc.Leaderboard
+	if err := json.Unmarshal(data, &lb); err != nil {
+		return nil, err
+	}
+	return &lb, nil
+}
+
+// Save writes lb to the cache for event+boardID, stamped with the current
+// time, so the next Load within the TTL is satisfied without a fetch.
+func Save(event, boardID string, lb *aoc.Leaderboard) error {
+	jp, err := jsonPath(event, boardID)
+	if err != nil {
+		return err
+	}
+	tp, err := tsPath(event, boardID)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(jp), 0o755); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(lb)
+	if err != nil {
+		return err
+	}
+
+	tmp := jp + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, jp); err != nil {
+		return err
+	}
+
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	return os.WriteFile(tp, []byte(ts), 0o644)
+}
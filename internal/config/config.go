@@ -10,14 +10,53 @@ import (
 
 var ErrNotFound = errors.New("config not found")
 
+// BoardEntry is one saved private leaderboard: an event (year) plus board ID,
+// labeled however the user likes. ViewKey is used to build the read-only JSON
+// URL; SessionCookie (added alongside authenticated fetches/submits) lets
+// aoccli hit the AoC API directly instead.
+type BoardEntry struct {
+	Label         string           `json:"label"`
+	Event         string           `json:"event"`
+	BoardID       string           `json:"board_id"`
+	ViewKey       string           `json:"view_key,omitempty"`
+	SessionCookie string           `json:"session_cookie,omitempty"`
+	Notifiers     []NotifierConfig `json:"notifiers,omitempty"`
+}
+
+// NotifierConfig describes one notification sink (see internal/notify) to
+// alert when this board's auto-refresh sees a new star. Template is an
+// optional Go text/template applied to a notify.StarEvent by the slack and
+// discord sinks; an empty Template falls back to the sink's default message.
+// The desktop and webhook sinks ignore Template.
+type NotifierConfig struct {
+	Type     string `json:"type"` // "desktop", "slack", "discord", or "webhook"
+	URL      string `json:"url,omitempty"`
+	Template string `json:"template,omitempty"`
+}
+
 type Config struct {
-	LeaderboardURL string `json:"leaderboard_url"`
+	Boards []BoardEntry `json:"boards"`
+	// DefaultBoard indexes into Boards for the board shown on startup. Nil
+	// means no board has been picked yet.
+	DefaultBoard *int `json:"default_board,omitempty"`
 }
 
 func Default() Config {
 	return Config{}
 }
 
+// ActiveBoard returns the board pointed to by DefaultBoard, if any.
+func (c Config) ActiveBoard() (*BoardEntry, bool) {
+	if c.DefaultBoard == nil {
+		return nil, false
+	}
+	i := *c.DefaultBoard
+	if i < 0 || i >= len(c.Boards) {
+		return nil, false
+	}
+	return &c.Boards[i], true
+}
+
 // Path returns the config file path, e.g. ~/.config/aoccli/config.json.
 func Path() (string, error) {
 	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
@@ -67,8 +106,10 @@ func Save(cfg Config) error {
 		return err
 	}
 
+	// 0600: boards may carry an AoC session cookie, so keep the file
+	// readable only by the owner.
 	tmp := p + ".tmp"
-	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
 		return err
 	}
 
@@ -0,0 +1,138 @@
+// Package notify delivers new-star alerts to pluggable sinks: a desktop
+// popup, a Slack or Discord webhook, or a generic JSON POST.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"text/template"
+
+	"github.com/gen2brain/beeep"
+
+	"github.com/LakshyaMittal3301/aoccli/internal/config"
+)
+
+// StarEvent describes a single member earning a star.
+type StarEvent struct {
+	Event              string
+	Day                int
+	Part               int
+	MemberName         string
+	DeltaSincePrevRank int // positions moved up in the overall standings since the last refresh, 0 if unknown
+	NewLocalScore      int
+}
+
+// DefaultMessage renders a StarEvent as a short, human-readable line.
+func (e StarEvent) DefaultMessage() string {
+	badge := "⭐"
+	if e.Part == 2 {
+		badge = "⭐⭐"
+	}
+	return fmt.Sprintf("%s just got day %d %s (%s, score %d)", e.MemberName, e.Day, badge, e.Event, e.NewLocalScore)
+}
+
+// Sink delivers a StarEvent somewhere.
+type Sink interface {
+	Notify(ctx context.Context, event StarEvent) error
+}
+
+// New builds the Sink described by cfg.
+func New(cfg config.NotifierConfig) (Sink, error) {
+	switch cfg.Type {
+	case "desktop":
+		return DesktopSink{}, nil
+	case "slack":
+		return SlackSink{URL: cfg.URL, Template: parseTemplate(cfg.Template)}, nil
+	case "discord":
+		return DiscordSink{URL: cfg.URL, Template: parseTemplate(cfg.Template)}, nil
+	case "webhook":
+		return WebhookSink{URL: cfg.URL}, nil
+	default:
+		return nil, fmt.Errorf("unknown notifier type %q", cfg.Type)
+	}
+}
+
+// DesktopSink shows a native OS notification.
+type DesktopSink struct{}
+
+func (DesktopSink) Notify(_ context.Context, event StarEvent) error {
+	return beeep.Notify("aoccli", event.DefaultMessage(), "")
+}
+
+// SlackSink posts to a Slack incoming webhook.
+type SlackSink struct {
+	URL      string
+	Template *template.Template // optional; nil uses StarEvent.DefaultMessage
+}
+
+func (s SlackSink) Notify(ctx context.Context, event StarEvent) error {
+	return postJSON(ctx, s.URL, map[string]string{"text": renderMessage(s.Template, event)})
+}
+
+// DiscordSink posts to a Discord webhook.
+type DiscordSink struct {
+	URL      string
+	Template *template.Template
+}
+
+func (s DiscordSink) Notify(ctx context.Context, event StarEvent) error {
+	return postJSON(ctx, s.URL, map[string]string{"content": renderMessage(s.Template, event)})
+}
+
+// WebhookSink posts the StarEvent itself as JSON to an arbitrary URL.
+type WebhookSink struct {
+	URL string
+}
+
+func (s WebhookSink) Notify(ctx context.Context, event StarEvent) error {
+	return postJSON(ctx, s.URL, event)
+}
+
+func renderMessage(tmpl *template.Template, event StarEvent) string {
+	if tmpl == nil {
+		return event.DefaultMessage()
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, event); err != nil {
+		return event.DefaultMessage()
+	}
+	return buf.String()
+}
+
+func parseTemplate(raw string) *template.Template {
+	if raw == "" {
+		return nil
+	}
+	t, err := template.New("notify").Parse(raw)
+	if err != nil {
+		return nil
+	}
+	return t
+}
+
+func postJSON(ctx context.Context, url string, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %s", resp.Status)
+	}
+	return nil
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -13,15 +13,42 @@ import (
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/LakshyaMittal3301/aoccli/internal/aoc"
+	"github.com/LakshyaMittal3301/aoccli/internal/cache"
 	"github.com/LakshyaMittal3301/aoccli/internal/config"
+	"github.com/LakshyaMittal3301/aoccli/internal/notify"
 )
 
 type appState int
 
 const (
-	stateConfig appState = iota
+	stateBoardForm appState = iota
+	stateBoards
 	stateLoading
 	stateLeaderboard
+	stateOverall
+	stateMemberDetail
+)
+
+// boardFormField is one step of the add/edit-board wizard, in entry order.
+type boardFormField int
+
+const (
+	fieldLabel boardFormField = iota
+	fieldEvent
+	fieldBoardID
+	fieldViewKey
+	fieldSessionCookie
+	numBoardFormFields
+)
+
+// submitField is one step of the answer-submission wizard, in entry order.
+type submitField int
+
+const (
+	submitFieldDay submitField = iota
+	submitFieldPart
+	submitFieldAnswer
+	numSubmitFields
 )
 
 type Model struct {
@@ -31,14 +58,62 @@ type Model struct {
 
 	textInput textinput.Model
 
+	// Board form (add/edit) state.
+	formField     boardFormField
+	formDraft     config.BoardEntry
+	formEditIndex int // index into cfg.Boards being edited, or -1 for a new board
+
+	// Board list (picker) state.
+	boardCursor int
+
 	leaderboard *aoc.Leaderboard
 	entries     []aoc.DayEntry
 	currentDay  int
 	maxDay      int
 
+	// leaderboardBoardKey identifies which board `leaderboard` was fetched
+	// for (see boardKey). Used to make sure a new-star diff never compares
+	// snapshots from two different boards.
+	leaderboardBoardKey string
+
+	// refreshInterval drives the auto-refresh ticker (0 disables it);
+	// cacheTTL is how fresh a cached fetch must be to reuse it.
+	refreshInterval time.Duration
+	cacheTTL        time.Duration
+	toast           string
+
+	// tickGen is bumped every time a new auto-refresh loop is started (i.e.
+	// a board becomes active). A tickMsg carrying a stale generation is
+	// dropped instead of rescheduling, so switching boards can't leave an
+	// old loop running alongside the new one.
+	tickGen int
+
 	dayPicker bool
 	pickerDay int
 
+	// Overall (season) leaderboard state.
+	overall       []aoc.OverallEntry
+	overallCursor int
+
+	// Member drill-down state (reached from the overall view).
+	detailMemberID string
+	detailName     string
+	detailEntries  []aoc.DayEntry // one entry per day, for detailMemberID
+
+	// Answer submission wizard state.
+	submitForm    bool
+	submitField   submitField
+	submitDay     int
+	submitPart    int
+	submitAnswer  string
+	submitBusy    bool
+	submitOutcome *aoc.SubmitOutcome
+
+	// Global leaderboard overlay state (the "g" key on the day view).
+	globalOverlay bool
+	globalBusy    bool
+	globalDaily   *aoc.DailyLeaderboard
+
 	width, height int
 
 	err error
@@ -46,39 +121,51 @@ type Model struct {
 
 // Messages for async work.
 type leaderboardLoadedMsg struct {
-	lb *aoc.Leaderboard
+	lb       *aoc.Leaderboard
+	boardKey string
 }
 
 type errMsg struct {
 	err error
 }
 
-// New builds the Bubbletea model, using cfg and cfgErr from config.Load().
-func New(cfg config.Config, cfgErr error) Model {
-	ti := textinput.New()
-	ti.Placeholder = "Paste AoC private leaderboard JSON URL"
-	ti.CharLimit = 512
-	ti.Width = 80
-	if cfg.LeaderboardURL == "" {
-		ti.Focus()
-	}
+type submitResultMsg struct {
+	outcome aoc.SubmitOutcome
+}
+
+// tickMsg fires the auto-refresh loop; see maybeScheduleTick. gen pins it to
+// the loop generation that scheduled it, so a stale loop from a since-
+// switched board can be told apart from the current one.
+type tickMsg struct {
+	gen int
+}
+
+type dailyGlobalLoadedMsg struct {
+	dl *aoc.DailyLeaderboard
+}
+
+type globalErrMsg struct {
+	err error
+}
 
+// New builds the Bubbletea model, using cfg and cfgErr from config.Load().
+// refreshInterval is how often the leaderboard is silently refetched while
+// viewing it (0 disables auto-refresh); cacheTTL is how old a cached fetch
+// may be before a fresh HTTP request is made.
+func New(cfg config.Config, cfgErr error, refreshInterval, cacheTTL time.Duration) Model {
 	m := Model{
-		cfg:        cfg,
-		cfgErr:     cfgErr,
-		textInput:  ti,
-		currentDay: 0, // pick last available day once data loads
+		cfg:             cfg,
+		cfgErr:          cfgErr,
+		formEditIndex:   -1,
+		currentDay:      0, // pick last available day once data loads
+		refreshInterval: refreshInterval,
+		cacheTTL:        cacheTTL,
 	}
 
-	if cfgErr == nil && cfg.LeaderboardURL != "" {
-		if err := validateLeaderboardURL(cfg.LeaderboardURL); err != nil {
-			m.state = stateConfig
-			m.err = err
-		} else {
-			m.state = stateLoading
-		}
+	if _, ok := cfg.ActiveBoard(); ok {
+		m.state = stateLoading
 	} else {
-		m.state = stateConfig
+		m.state = stateBoards
 		if cfgErr != nil && !errors.Is(cfgErr, config.ErrNotFound) {
 			m.err = cfgErr
 		}
@@ -89,28 +176,121 @@ func New(cfg config.Config, cfgErr error) Model {
 
 func (m Model) Init() tea.Cmd {
 	switch m.state {
-	case stateConfig:
+	case stateBoardForm:
 		return textinput.Blink
 	case stateLoading:
-		return fetchLeaderboardCmd(m.cfg.LeaderboardURL)
+		if board, ok := m.cfg.ActiveBoard(); ok {
+			return tea.Batch(fetchLeaderboardCmd(*board, m.cacheTTL, false), m.maybeScheduleTick(m.tickGen))
+		}
+		return nil
 	default:
 		return nil
 	}
 }
 
-func fetchLeaderboardCmd(url string) tea.Cmd {
+// boardKey identifies a board's leaderboard snapshot, distinct across
+// boards (event+board ID is what the cache is keyed on too; see
+// internal/cache).
+func boardKey(b config.BoardEntry) string {
+	return b.Event + "#" + b.BoardID
+}
+
+// maybeScheduleTick schedules the next silent auto-refresh, if enabled and a
+// board is active. gen pins the resulting tickMsg to the loop generation
+// that's requesting it (see tickGen).
+func (m Model) maybeScheduleTick(gen int) tea.Cmd {
+	if m.refreshInterval <= 0 {
+		return nil
+	}
+	if _, ok := m.cfg.ActiveBoard(); !ok {
+		return nil
+	}
+	return tea.Tick(m.refreshInterval, func(time.Time) tea.Msg { return tickMsg{gen: gen} })
+}
+
+// fetchLeaderboardCmd fetches a board's leaderboard, consulting the disk
+// cache first unless force is set (used for an explicit refresh and by the
+// auto-refresh ticker). A successful network fetch is written back to the cache.
+func fetchLeaderboardCmd(board config.BoardEntry, cacheTTL time.Duration, force bool) tea.Cmd {
+	key := boardKey(board)
 	return func() tea.Msg {
+		if !force && cacheTTL > 0 {
+			if lb, err := cache.Load(board.Event, board.BoardID, cacheTTL); err == nil {
+				return leaderboardLoadedMsg{lb: lb, boardKey: key}
+			}
+		}
+
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
 
-		lb, err := aoc.FetchLeaderboard(ctx, url)
+		var (
+			lb  *aoc.Leaderboard
+			err error
+		)
+		if board.SessionCookie != "" {
+			lb, err = aoc.FetchLeaderboardWithSession(ctx, board.Event, board.BoardID, board.SessionCookie)
+		} else {
+			url := aoc.PrivateLeaderboardURL(board.Event, board.BoardID, board.ViewKey)
+			lb, err = aoc.FetchLeaderboard(ctx, url)
+		}
 		if err != nil {
 			return errMsg{err: err}
 		}
-		return leaderboardLoadedMsg{lb: lb}
+
+		_ = cache.Save(board.Event, board.BoardID, lb) // best-effort; a cache miss just means a fetch next time
+
+		return leaderboardLoadedMsg{lb: lb, boardKey: key}
 	}
 }
 
+// fetchDailyGlobalCmd fetches the public top-100 daily leaderboard for the
+// gold-cap overlay.
+func fetchDailyGlobalCmd(year, day int) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		dl, err := aoc.FetchDailyLeaderboard(ctx, year, day)
+		if err != nil {
+			return globalErrMsg{err: err}
+		}
+		return dailyGlobalLoadedMsg{dl: dl}
+	}
+}
+
+func submitAnswerCmd(sessionCookie string, year, day, part int, answer string, alreadyCompleted bool) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		outcome, err := aoc.Submit(ctx, sessionCookie, year, day, part, answer, alreadyCompleted)
+		if err != nil {
+			return errMsg{err: err}
+		}
+		return submitResultMsg{outcome: outcome}
+	}
+}
+
+// ownAlreadyCompleted reports whether the board's owner (lb.OwnerID) already
+// has a star for day/part, per the last fetched snapshot. This reflects the
+// submitting user only when the session cookie belongs to the board's owner;
+// other members' completions are not consulted.
+func ownAlreadyCompleted(lb *aoc.Leaderboard, day, part int) bool {
+	if lb == nil {
+		return false
+	}
+	owner, ok := lb.Members[strconv.Itoa(lb.OwnerID)]
+	if !ok || owner.CompletionDayLevel == nil {
+		return false
+	}
+	dayData, ok := owner.CompletionDayLevel[strconv.Itoa(day)]
+	if !ok {
+		return false
+	}
+	_, ok = dayData[strconv.Itoa(part)]
+	return ok
+}
+
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
@@ -123,10 +303,39 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if m.state == stateLoading {
 			m.state = stateLeaderboard
 		}
+		m.submitBusy = false
+		// The auto-refresh loop (if any) was already started when this board
+		// became active, so a failed fetch doesn't need to (re)schedule one.
+		return m, nil
+
+	case submitResultMsg:
+		m.submitBusy = false
+		outcome := msg.outcome
+		m.submitOutcome = &outcome
+		return m, nil
+
+	case dailyGlobalLoadedMsg:
+		m.globalBusy = false
+		m.globalDaily = msg.dl
+		m.err = nil
+		return m, nil
+
+	case globalErrMsg:
+		m.globalBusy = false
+		m.err = msg.err
 		return m, nil
 
 	case leaderboardLoadedMsg:
+		// Only diff against the previous snapshot if it's actually the same
+		// board's; otherwise (e.g. right after switching boards) every
+		// member on the new board would look like a fresh star.
+		var events []notify.StarEvent
+		if m.leaderboard != nil && m.leaderboardBoardKey == msg.boardKey {
+			events = newStarEvents(m.leaderboard, msg.lb)
+		}
+		m.toast = toastForEvents(events)
 		m.leaderboard = msg.lb
+		m.leaderboardBoardKey = msg.boardKey
 		m.maxDay = aoc.MaxAvailableDay(msg.lb)
 		if m.currentDay < 1 {
 			m.currentDay = m.maxDay
@@ -137,12 +346,36 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.entries = aoc.BuildDayEntries(msg.lb, m.currentDay)
 		m.state = stateLeaderboard
 		m.err = nil
-		return m, nil
+
+		var notifyCmdFn tea.Cmd
+		if board, ok := m.cfg.ActiveBoard(); ok {
+			notifyCmdFn = notifyEventsCmd(*board, events)
+		}
+		// The auto-refresh loop (if any) was already started when this
+		// board became active (see switchToBoard/Init); a load completing
+		// doesn't start another one.
+		return m, notifyCmdFn
+
+	case tickMsg:
+		if msg.gen != m.tickGen {
+			// Stale loop left over from a board that's no longer active.
+			return m, nil
+		}
+		next := m.maybeScheduleTick(m.tickGen)
+		if m.state != stateLeaderboard {
+			return m, next
+		}
+		if board, ok := m.cfg.ActiveBoard(); ok {
+			return m, tea.Batch(next, fetchLeaderboardCmd(*board, m.cacheTTL, true))
+		}
+		return m, next
 
 	case tea.KeyMsg:
 		switch m.state {
-		case stateConfig:
-			return m.updateConfigKey(msg)
+		case stateBoardForm:
+			return m.updateBoardFormKey(msg)
+		case stateBoards:
+			return m.updateBoardsKey(msg)
 		case stateLoading:
 			if key := msg.String(); key == "ctrl+c" || key == "q" {
 				return m, tea.Quit
@@ -150,38 +383,109 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		case stateLeaderboard:
 			return m.updateLeaderboardKey(msg)
+		case stateOverall:
+			return m.updateOverallKey(msg)
+		case stateMemberDetail:
+			return m.updateMemberDetailKey(msg)
 		}
 	}
 
-	// Let the text input handle messages in config mode (e.g. cursor, typing).
-	if m.state == stateConfig {
-		var cmd tea.Cmd
-		m.textInput, cmd = m.textInput.Update(msg)
-		return m, cmd
+	return m, nil
+}
+
+// startBoardForm resets the wizard to its first field. editIndex is -1 for a
+// brand-new board, or the index of an existing board being renamed/edited.
+func (m *Model) startBoardForm(editIndex int) {
+	m.formEditIndex = editIndex
+	m.formField = fieldLabel
+	if editIndex >= 0 {
+		m.formDraft = m.cfg.Boards[editIndex]
+	} else {
+		m.formDraft = config.BoardEntry{}
 	}
 
-	return m, nil
+	ti := textinput.New()
+	ti.CharLimit = 512
+	ti.Width = 60
+	ti.Focus()
+	m.populateFormInput(&ti)
+	m.textInput = ti
+	m.state = stateBoardForm
+	m.err = nil
+}
+
+// populateFormInput sets the placeholder and prefilled value for the current
+// wizard field.
+func (m Model) populateFormInput(ti *textinput.Model) {
+	switch m.formField {
+	case fieldLabel:
+		ti.Placeholder = "Label for this board (e.g. \"Work 2024\")"
+		ti.SetValue(m.formDraft.Label)
+	case fieldEvent:
+		ti.Placeholder = "Event year (e.g. 2024)"
+		ti.SetValue(m.formDraft.Event)
+	case fieldBoardID:
+		ti.Placeholder = "Board ID"
+		ti.SetValue(m.formDraft.BoardID)
+	case fieldViewKey:
+		ti.Placeholder = "View key (leave blank if using a session cookie)"
+		ti.SetValue(m.formDraft.ViewKey)
+	case fieldSessionCookie:
+		ti.Placeholder = "AoC session cookie (optional, leave blank to use the view key)"
+		ti.SetValue(m.formDraft.SessionCookie)
+	}
 }
 
-func (m Model) updateConfigKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+func (m Model) updateBoardFormKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	case "enter":
-		url := strings.TrimSpace(m.textInput.Value())
-		if err := validateLeaderboardURL(url); err != nil {
-			m.err = err
+		value := strings.TrimSpace(m.textInput.Value())
+		switch m.formField {
+		case fieldLabel:
+			if value == "" {
+				m.err = errors.New("label cannot be empty")
+				return m, nil
+			}
+			m.formDraft.Label = value
+		case fieldEvent:
+			if _, err := strconv.Atoi(value); err != nil {
+				m.err = errors.New("event year must be numeric")
+				return m, nil
+			}
+			m.formDraft.Event = value
+		case fieldBoardID:
+			if value == "" {
+				m.err = errors.New("board ID cannot be empty")
+				return m, nil
+			}
+			m.formDraft.BoardID = value
+		case fieldViewKey:
+			m.formDraft.ViewKey = value
+		case fieldSessionCookie:
+			m.formDraft.SessionCookie = value
+		}
+		m.err = nil
+
+		if m.formField+1 < numBoardFormFields {
+			m.formField++
+			m.populateFormInput(&m.textInput)
 			return m, nil
 		}
-		m.cfg.LeaderboardURL = url
-		if err := config.Save(m.cfg); err != nil {
-			m.err = err
+
+		if m.formDraft.ViewKey == "" && m.formDraft.SessionCookie == "" {
+			m.err = errors.New("provide either a view key or a session cookie")
 			return m, nil
 		}
-		m.state = stateLoading
-		m.err = nil
-		return m, fetchLeaderboardCmd(url)
 
-	case "ctrl+c", "esc":
+		return m.commitBoardForm()
+
+	case "ctrl+c":
 		return m, tea.Quit
+
+	case "esc":
+		m.state = stateBoards
+		m.err = nil
+		return m, nil
 	}
 
 	var cmd tea.Cmd
@@ -189,6 +493,105 @@ func (m Model) updateConfigKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, cmd
 }
 
+// commitBoardForm saves the completed draft as the active board and kicks
+// off a fetch for it.
+func (m Model) commitBoardForm() (tea.Model, tea.Cmd) {
+	if m.formEditIndex >= 0 {
+		m.cfg.Boards[m.formEditIndex] = m.formDraft
+	} else {
+		m.cfg.Boards = append(m.cfg.Boards, m.formDraft)
+		m.formEditIndex = len(m.cfg.Boards) - 1
+	}
+	idx := m.formEditIndex
+	m.cfg.DefaultBoard = &idx
+
+	if err := config.Save(m.cfg); err != nil {
+		m.err = err
+		return m, nil
+	}
+
+	return m.switchToBoard(idx)
+}
+
+// switchToBoard makes cfg.Boards[idx] the one being viewed and kicks off a
+// fresh fetch plus auto-refresh loop for it. Clearing the previous snapshot
+// keeps the next new-star diff from comparing it against an unrelated
+// board; bumping tickGen retires any refresh loop still running for the
+// board being left, so switching boards never leaves two loops going.
+func (m Model) switchToBoard(idx int) (tea.Model, tea.Cmd) {
+	m.state = stateLoading
+	m.err = nil
+	m.leaderboard = nil
+	m.leaderboardBoardKey = ""
+	m.tickGen++
+
+	board := m.cfg.Boards[idx]
+	return m, tea.Batch(fetchLeaderboardCmd(board, m.cacheTTL, false), m.maybeScheduleTick(m.tickGen))
+}
+
+func (m Model) updateBoardsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	key := msg.String()
+
+	if key == "ctrl+c" || key == "q" {
+		return m, tea.Quit
+	}
+
+	switch key {
+	case "up", "k":
+		if m.boardCursor > 0 {
+			m.boardCursor--
+		}
+	case "down", "j":
+		if m.boardCursor < len(m.cfg.Boards)-1 {
+			m.boardCursor++
+		}
+	case "a":
+		m.startBoardForm(-1)
+		return m, textinput.Blink
+	case "r", "e":
+		if len(m.cfg.Boards) > 0 {
+			m.startBoardForm(m.boardCursor)
+			return m, textinput.Blink
+		}
+	case "d":
+		if len(m.cfg.Boards) > 0 {
+			m.cfg.Boards = append(m.cfg.Boards[:m.boardCursor], m.cfg.Boards[m.boardCursor+1:]...)
+			if m.cfg.DefaultBoard != nil {
+				switch {
+				case *m.cfg.DefaultBoard == m.boardCursor:
+					m.cfg.DefaultBoard = nil
+				case *m.cfg.DefaultBoard > m.boardCursor:
+					*m.cfg.DefaultBoard--
+				}
+			}
+			if m.boardCursor >= len(m.cfg.Boards) && m.boardCursor > 0 {
+				m.boardCursor--
+			}
+			if err := config.Save(m.cfg); err != nil {
+				m.err = err
+			}
+		}
+	case "enter":
+		if len(m.cfg.Boards) == 0 {
+			return m, nil
+		}
+		idx := m.boardCursor
+		m.cfg.DefaultBoard = &idx
+		if err := config.Save(m.cfg); err != nil {
+			m.err = err
+			return m, nil
+		}
+		return m.switchToBoard(idx)
+	case "esc":
+		if m.leaderboard != nil {
+			m.state = stateLeaderboard
+			m.err = nil
+		}
+	}
+
+	return m, nil
+}
+
 func (m Model) updateLeaderboardKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	key := msg.String()
 
@@ -200,6 +603,30 @@ func (m Model) updateLeaderboardKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
+	// Answer-submission wizard mode.
+	if m.submitForm {
+		return m.updateSubmitFormKey(msg)
+	}
+	if m.submitBusy {
+		return m, nil
+	}
+	if m.submitOutcome != nil {
+		switch key {
+		case "enter", "esc":
+			m.submitOutcome = nil
+		}
+		return m, nil
+	}
+
+	// Global leaderboard overlay mode.
+	if m.globalOverlay {
+		switch key {
+		case "esc", "g":
+			m.globalOverlay = false
+		}
+		return m, nil
+	}
+
 	// Day picker mode
 	if m.dayPicker {
 		switch key {
@@ -222,6 +649,7 @@ func (m Model) updateLeaderboardKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	}
 
 	// Normal leaderboard navigation.
+	m.toast = ""
 	switch key {
 	case "left", "h":
 		if m.currentDay > 1 {
@@ -236,9 +664,299 @@ func (m Model) updateLeaderboardKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case "d":
 		m.dayPicker = true
 		m.pickerDay = m.currentDay
+	case "b":
+		m.state = stateBoards
+		if board, ok := m.cfg.ActiveBoard(); ok {
+			for i := range m.cfg.Boards {
+				if &m.cfg.Boards[i] == board {
+					m.boardCursor = i
+					break
+				}
+			}
+		}
+		return m, nil
 	case "r":
-		m.state = stateLoading
-		return m, fetchLeaderboardCmd(m.cfg.LeaderboardURL)
+		if board, ok := m.cfg.ActiveBoard(); ok {
+			m.state = stateLoading
+			return m, fetchLeaderboardCmd(*board, m.cacheTTL, true)
+		}
+	case "s":
+		board, ok := m.cfg.ActiveBoard()
+		if !ok || board.SessionCookie == "" {
+			m.err = errors.New("submitting answers needs a session cookie on the active board (press b, r to edit it)")
+			return m, nil
+		}
+		m.startSubmitForm()
+		return m, textinput.Blink
+	case "o":
+		m.overall = aoc.BuildOverall(m.leaderboard)
+		m.overallCursor = 0
+		m.state = stateOverall
+		m.err = nil
+		return m, nil
+	case "g":
+		year, err := strconv.Atoi(m.leaderboard.Event)
+		if err != nil {
+			m.err = fmt.Errorf("board event %q is not a valid year", m.leaderboard.Event)
+			return m, nil
+		}
+		m.globalOverlay = true
+		m.globalBusy = true
+		m.globalDaily = nil
+		m.err = nil
+		return m, fetchDailyGlobalCmd(year, m.currentDay)
+	}
+
+	return m, nil
+}
+
+// startSubmitForm resets the answer-submission wizard to its first field.
+func (m *Model) startSubmitForm() {
+	m.submitForm = true
+	m.submitField = submitFieldDay
+	m.submitDay = m.currentDay
+	m.submitPart = 1
+	m.submitAnswer = ""
+	m.submitOutcome = nil
+
+	ti := textinput.New()
+	ti.CharLimit = 64
+	ti.Width = 40
+	ti.Focus()
+	m.populateSubmitInput(&ti)
+	m.textInput = ti
+	m.err = nil
+}
+
+func (m Model) populateSubmitInput(ti *textinput.Model) {
+	switch m.submitField {
+	case submitFieldDay:
+		ti.Placeholder = "Day (1-25)"
+		ti.SetValue(strconv.Itoa(m.submitDay))
+	case submitFieldPart:
+		ti.Placeholder = "Part (1 or 2)"
+		ti.SetValue(strconv.Itoa(m.submitPart))
+	case submitFieldAnswer:
+		ti.Placeholder = "Answer"
+		ti.SetValue(m.submitAnswer)
+	}
+}
+
+func (m Model) updateSubmitFormKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		value := strings.TrimSpace(m.textInput.Value())
+		switch m.submitField {
+		case submitFieldDay:
+			day, err := strconv.Atoi(value)
+			if err != nil || day < 1 || day > 25 {
+				m.err = errors.New("day must be a number from 1 to 25")
+				return m, nil
+			}
+			m.submitDay = day
+		case submitFieldPart:
+			part, err := strconv.Atoi(value)
+			if err != nil || (part != 1 && part != 2) {
+				m.err = errors.New("part must be 1 or 2")
+				return m, nil
+			}
+			m.submitPart = part
+		case submitFieldAnswer:
+			if value == "" {
+				m.err = errors.New("answer cannot be empty")
+				return m, nil
+			}
+			m.submitAnswer = value
+		}
+		m.err = nil
+
+		if m.submitField+1 < numSubmitFields {
+			m.submitField++
+			m.populateSubmitInput(&m.textInput)
+			return m, nil
+		}
+
+		board, ok := m.cfg.ActiveBoard()
+		if !ok || board.SessionCookie == "" {
+			m.err = errors.New("no session cookie on the active board")
+			m.submitForm = false
+			return m, nil
+		}
+
+		year, err := strconv.Atoi(board.Event)
+		if err != nil {
+			m.err = fmt.Errorf("board event %q is not a valid year", board.Event)
+			m.submitForm = false
+			return m, nil
+		}
+
+		m.submitForm = false
+		m.submitBusy = true
+		alreadyCompleted := ownAlreadyCompleted(m.leaderboard, m.submitDay, m.submitPart)
+		return m, submitAnswerCmd(board.SessionCookie, year, m.submitDay, m.submitPart, m.submitAnswer, alreadyCompleted)
+
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case "esc":
+		m.submitForm = false
+		m.err = nil
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.textInput, cmd = m.textInput.Update(msg)
+	return m, cmd
+}
+
+func (m Model) updateOverallKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	key := msg.String()
+
+	if key == "ctrl+c" || key == "q" {
+		return m, tea.Quit
+	}
+
+	switch key {
+	case "up", "k":
+		if m.overallCursor > 0 {
+			m.overallCursor--
+		}
+	case "down", "j":
+		if m.overallCursor < len(m.overall)-1 {
+			m.overallCursor++
+		}
+	case "enter":
+		if len(m.overall) == 0 {
+			return m, nil
+		}
+		selected := m.overall[m.overallCursor]
+		m.detailMemberID = selected.MemberID
+		m.detailName = selected.Name
+		m.detailEntries = memberDayEntries(m.leaderboard, selected.MemberID, m.maxDay)
+		m.state = stateMemberDetail
+	case "esc", "o":
+		m.state = stateLeaderboard
+		m.err = nil
+	}
+
+	return m, nil
+}
+
+// memberDayEntries builds one DayEntry per day for a single member, reusing
+// BuildDayEntries so the per-day scoring logic stays in one place.
+func memberDayEntries(lb *aoc.Leaderboard, memberID string, maxDay int) []aoc.DayEntry {
+	entries := make([]aoc.DayEntry, 0, maxDay)
+	for day := 1; day <= maxDay; day++ {
+		for _, e := range aoc.BuildDayEntries(lb, day) {
+			if e.MemberID == memberID {
+				entries = append(entries, e)
+				break
+			}
+		}
+	}
+	return entries
+}
+
+// newStarEvents compares two snapshots of the same leaderboard and returns a
+// notify.StarEvent for every star in next that wasn't in prev. prev is nil
+// on the very first load, so nothing is ever reported then.
+func newStarEvents(prev, next *aoc.Leaderboard) []notify.StarEvent {
+	if prev == nil || next == nil {
+		return nil
+	}
+
+	prevPos := make(map[string]int, len(prev.Members))
+	for _, e := range aoc.BuildOverall(prev) {
+		prevPos[e.MemberID] = e.Pos
+	}
+	nextOverall := aoc.BuildOverall(next)
+	nextPos := make(map[string]int, len(nextOverall))
+	nextScore := make(map[string]int, len(nextOverall))
+	for _, e := range nextOverall {
+		nextPos[e.MemberID] = e.Pos
+		nextScore[e.MemberID] = e.LocalScore
+	}
+
+	var events []notify.StarEvent
+	for key, nextMember := range next.Members {
+		var prevDays map[string]map[string]aoc.StarCompletion
+		if prevMember, ok := prev.Members[key]; ok {
+			prevDays = prevMember.CompletionDayLevel
+		}
+
+		for dayStr, dayData := range nextMember.CompletionDayLevel {
+			prevDay := prevDays[dayStr]
+			for part := range dayData {
+				if _, had := prevDay[part]; had {
+					continue
+				}
+				day, _ := strconv.Atoi(dayStr)
+				partNum, _ := strconv.Atoi(part)
+				delta := 0
+				if p, ok := prevPos[key]; ok {
+					delta = p - nextPos[key]
+				}
+				events = append(events, notify.StarEvent{
+					Event:              next.Event,
+					Day:                day,
+					Part:               partNum,
+					MemberName:         nextMember.DisplayName(),
+					DeltaSincePrevRank: delta,
+					NewLocalScore:      nextScore[key],
+				})
+			}
+		}
+	}
+
+	return events
+}
+
+// toastForEvents renders a short transient banner for a batch of new-star
+// events, or "" if there are none.
+func toastForEvents(events []notify.StarEvent) string {
+	if len(events) == 0 {
+		return ""
+	}
+	messages := make([]string, len(events))
+	for i, e := range events {
+		messages[i] = e.DefaultMessage()
+	}
+	return strings.Join(messages, " · ")
+}
+
+// notifyEventsCmd fires every sink configured on board for each event. It's
+// best-effort: a failing sink doesn't block or surface an error to the UI.
+func notifyEventsCmd(board config.BoardEntry, events []notify.StarEvent) tea.Cmd {
+	if len(events) == 0 || len(board.Notifiers) == 0 {
+		return nil
+	}
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		for _, nc := range board.Notifiers {
+			sink, err := notify.New(nc)
+			if err != nil {
+				continue
+			}
+			for _, e := range events {
+				_ = sink.Notify(ctx, e)
+			}
+		}
+		return nil
+	}
+}
+
+func (m Model) updateMemberDetailKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	key := msg.String()
+
+	if key == "ctrl+c" || key == "q" {
+		return m, tea.Quit
+	}
+
+	switch key {
+	case "esc", "enter":
+		m.state = stateOverall
 	}
 
 	return m, nil
@@ -246,12 +964,18 @@ func (m Model) updateLeaderboardKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 func (m Model) View() string {
 	switch m.state {
-	case stateConfig:
-		return m.viewConfig()
+	case stateBoardForm:
+		return m.viewBoardForm()
+	case stateBoards:
+		return m.viewBoards()
 	case stateLoading:
 		return m.viewLoading()
 	case stateLeaderboard:
 		return m.viewLeaderboard()
+	case stateOverall:
+		return m.viewOverall()
+	case stateMemberDetail:
+		return m.viewMemberDetail()
 	default:
 		return ""
 	}
@@ -290,15 +1014,19 @@ var (
 			Foreground(lipgloss.Color("252")) // soft off-white
 )
 
-func (m Model) viewConfig() string {
+func (m Model) viewBoardForm() string {
 	var b strings.Builder
 
-	fmt.Fprintln(&b, titleStyle.Render("Advent of Code â€“ aoccli"))
+	fmt.Fprintln(&b, titleStyle.Render("Advent of Code – aoccli"))
 	fmt.Fprintln(&b)
-	fmt.Fprintln(&b, "Enter your private leaderboard JSON URL:")
+	if m.formEditIndex >= 0 {
+		fmt.Fprintln(&b, "Edit board:")
+	} else {
+		fmt.Fprintln(&b, "Add a board:")
+	}
 	fmt.Fprintln(&b, m.textInput.View())
 	fmt.Fprintln(&b)
-	fmt.Fprintln(&b, helpStyle.Render("Press Enter to save, Esc or Ctrl+C to quit."))
+	fmt.Fprintln(&b, helpStyle.Render(fmt.Sprintf("Step %d/%d · Enter to continue, Esc to cancel, Ctrl+C to quit.", m.formField+1, numBoardFormFields)))
 
 	if m.err != nil {
 		fmt.Fprintln(&b, errorStyle.Render("Error: "+m.err.Error()))
@@ -307,16 +1035,44 @@ func (m Model) viewConfig() string {
 	return b.String()
 }
 
-func (m Model) viewLoading() string {
+func (m Model) viewBoards() string {
 	var b strings.Builder
 
-	fmt.Fprintln(&b, titleStyle.Render("Advent of Code â€“ aoccli"))
+	fmt.Fprintln(&b, titleStyle.Render("Advent of Code – aoccli"))
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, headerStyle.Render("Boards"))
+
+	if len(m.cfg.Boards) == 0 {
+		fmt.Fprintln(&b, "No boards saved yet. Press a to add one.")
+	}
+
+	for i, board := range m.cfg.Boards {
+		cursor := "  "
+		if i == m.boardCursor {
+			cursor = "➤ "
+		}
+		active := ""
+		if m.cfg.DefaultBoard != nil && *m.cfg.DefaultBoard == i {
+			active = " (active)"
+		}
+		fmt.Fprintf(&b, "%s%s — %s board %s%s\n", cursor, board.Label, board.Event, board.BoardID, active)
+	}
+
 	fmt.Fprintln(&b)
-	msg := "Loading leaderboard..."
-	if m.cfg.LeaderboardURL == "" {
-		msg = "No leaderboard URL configured."
+	if m.err != nil {
+		fmt.Fprintln(&b, errorStyle.Render("Error: "+m.err.Error()))
 	}
-	fmt.Fprintln(&b, msg)
+	fmt.Fprintln(&b, helpStyle.Render("↑/↓ or j/k move · Enter select · a add · r rename/edit · d delete · Esc back · q quit"))
+
+	return b.String()
+}
+
+func (m Model) viewLoading() string {
+	var b strings.Builder
+
+	fmt.Fprintln(&b, titleStyle.Render("Advent of Code – aoccli"))
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "Loading leaderboard...")
 
 	if m.err != nil {
 		fmt.Fprintln(&b, errorStyle.Render("Error: "+m.err.Error()))
@@ -333,30 +1089,105 @@ func (m Model) viewLeaderboard() string {
 
 	if m.leaderboard == nil {
 		fmt.Fprintln(&b, "No leaderboard loaded.")
-		fmt.Fprintln(&b, helpStyle.Render("Press r to reload or q to quit."))
+		fmt.Fprintln(&b, helpStyle.Render("Press q to quit."))
 		return b.String()
 	}
 
-	header := fmt.Sprintf("Advent of Code %s â€“ Day %d / %d", m.leaderboard.Event, m.currentDay, m.maxDay)
+	label := ""
+	if board, ok := m.cfg.ActiveBoard(); ok {
+		label = board.Label + " · "
+	}
+	header := fmt.Sprintf("%sAdvent of Code %s board %s – Day %d / %d", label, m.leaderboard.Event, boardIDOrDash(m.cfg), m.currentDay, m.maxDay)
 	fmt.Fprintln(&b, titleStyle.Render(header))
 
 	if m.err != nil {
 		fmt.Fprintln(&b, errorStyle.Render("Error: "+m.err.Error()))
 	}
+	if m.toast != "" {
+		fmt.Fprintln(&b, titleStyle.Render(m.toast))
+	}
+	if year, err := strconv.Atoi(m.leaderboard.Event); err == nil {
+		if release := aoc.ChallengeReleaseTime(year, m.currentDay); time.Now().Before(release) {
+			fmt.Fprintln(&b, helpStyle.Render(fmt.Sprintf("Day %d unlocks in %s (at %s)", m.currentDay, formatDuration(time.Until(release)), release.Format("Jan 2 15:04 MST"))))
+		}
+	}
 	fmt.Fprintln(&b)
 
+	// Global leaderboard overlay.
+	if m.globalOverlay {
+		fmt.Fprintln(&b, headerStyle.Render(fmt.Sprintf("Global top 100 – Day %d", m.currentDay)))
+		if m.globalBusy {
+			fmt.Fprintln(&b, "Loading global leaderboard...")
+			return b.String()
+		}
+		if m.globalDaily == nil {
+			fmt.Fprintln(&b, "No data.")
+			fmt.Fprintln(&b, helpStyle.Render("g/Esc back · q quit"))
+			return b.String()
+		}
+
+		local := make(map[string]bool, len(m.entries))
+		for _, e := range m.entries {
+			local[e.Name] = true
+		}
+
+		renderPart := func(title string, part []aoc.DailyLeaderboardEntry) {
+			fmt.Fprintln(&b, headerStyle.Render(title))
+			if len(part) == 0 {
+				fmt.Fprintln(&b, "  (not released yet, or no data)")
+				return
+			}
+			for _, e := range part {
+				mark := "  "
+				if local[e.Name] {
+					mark = "🏅" // gold cap: a private-board member cracked the global top 100
+				}
+				fmt.Fprintf(&b, "%s%3d) %-10s %s\n", mark, e.Rank, formatDuration(e.Since), e.Name)
+			}
+		}
+		renderPart("Part 1", m.globalDaily.Part1)
+		fmt.Fprintln(&b)
+		renderPart("Part 2", m.globalDaily.Part2)
+
+		fmt.Fprintln(&b)
+		fmt.Fprintln(&b, helpStyle.Render("🏅 marks a member of this board who cracked the global top 100 · g/Esc back · q quit"))
+		return b.String()
+	}
+
+	// Answer-submission overlay.
+	if m.submitForm {
+		fmt.Fprintln(&b, headerStyle.Render("Submit an answer"))
+		fmt.Fprintln(&b, m.textInput.View())
+		fmt.Fprintln(&b)
+		fmt.Fprintln(&b, helpStyle.Render(fmt.Sprintf("Step %d/%d · Enter to continue, Esc to cancel", m.submitField+1, numSubmitFields)))
+		return b.String()
+	}
+	if m.submitBusy {
+		fmt.Fprintln(&b, "Submitting answer...")
+		return b.String()
+	}
+	if m.submitOutcome != nil {
+		fmt.Fprintln(&b, headerStyle.Render("Submission result: "+submitResultLabel(m.submitOutcome.Result)))
+		if m.submitOutcome.Result == aoc.SubmitWait && m.submitOutcome.Cooldown > 0 {
+			fmt.Fprintln(&b, "Try again in about "+formatDuration(m.submitOutcome.Cooldown)+".")
+		}
+		fmt.Fprintln(&b)
+		fmt.Fprintln(&b, helpStyle.Render("Enter or Esc to dismiss"))
+		return b.String()
+	}
+
 	// Day picker overlay.
 	if m.dayPicker {
 		fmt.Fprintln(&b, headerStyle.Render("Select day"))
 		for d := 1; d <= m.maxDay; d++ {
 			cursor := "  "
 			if d == m.pickerDay {
-				cursor = "âž¤ "
+				cursor = "➤ "
 			}
 			fmt.Fprintf(&b, "%sDay %02d\n", cursor, d)
 		}
 		fmt.Fprintln(&b)
-		fmt.Fprintln(&b, helpStyle.Render("â†‘/â†“ or j/k to move Â· Enter to select Â· d/Esc to cancel Â· q to quit"))
+		fmt.Fprintln(&b, helpStyle.Render("↑/↓ or j/k to move · Enter to select · d/Esc to cancel · q to quit"))
 		return b.String()
 	}
 
@@ -392,15 +1223,15 @@ func (m Model) viewLeaderboard() string {
 		// Badge: one gold star for part 1, gold burst for both parts.
 		badge := ""
 		if e.StarsToday == 1 {
-			badge = " âœ¸"
+			badge = " ✸"
 		} else if e.StarsToday == 2 {
-			badge = " â­"
+			badge = " ⭐"
 		}
 
 		name := e.Name + badge
 		// Trophy for the top row, placed after the name.
 		if i == 0 {
-			name += " ðŸ†"
+			name += " 🏆"
 		}
 		name = truncate(name, 30)
 
@@ -419,12 +1250,108 @@ func (m Model) viewLeaderboard() string {
 	fmt.Fprintln(&b, tableBoxStyle.Render(strings.TrimRight(table.String(), "\n")))
 
 	fmt.Fprintln(&b)
-	fmt.Fprintln(&b, helpStyle.Render("â†/h prev day Â· â†’/l next day Â· d day list Â· r refresh Â· q quit"))
+	fmt.Fprintln(&b, helpStyle.Render("←/h prev day · →/l next day · d day list · o overall · g global top 100 · b boards · s submit answer · r refresh · q quit"))
 	fmt.Fprintln(&b, helpStyle.Render("Times are HH:MM:SS since midnight (UTC-5) release."))
 
 	return b.String()
 }
 
+func (m Model) viewOverall() string {
+	var b strings.Builder
+
+	fmt.Fprintln(&b, titleStyle.Render(fmt.Sprintf("Advent of Code %s – Overall standings", m.leaderboard.Event)))
+	fmt.Fprintln(&b)
+
+	var table strings.Builder
+	fmt.Fprintln(&table, tableHeaderRowStyle.Render(
+		fmt.Sprintf("%5s   %5s   %5s   %-27s   %-32s", "Pos", "Pts", "Stars", "Calendar", "Name"),
+	))
+	fmt.Fprintln(&table)
+
+	lastPos := -1
+	for i, e := range m.overall {
+		cursor := "  "
+		if i == m.overallCursor {
+			cursor = "➤ "
+		}
+
+		posStr := ""
+		if e.Pos != lastPos {
+			posStr = formatPosition(e.Pos)
+			lastPos = e.Pos
+		}
+
+		line := fmt.Sprintf(
+			"%s%5s   %5d   %5d   %-27s   %-32s",
+			cursor, posStr, e.LocalScore, e.TotalStars, e.StarMap, truncate(e.Name, 30),
+		)
+		fmt.Fprintln(&table, tableRowStyle.Render(line))
+	}
+
+	fmt.Fprintln(&b, tableBoxStyle.Render(strings.TrimRight(table.String(), "\n")))
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, helpStyle.Render("↑/↓ or j/k move · Enter for member detail · Esc/o back · q quit"))
+
+	return b.String()
+}
+
+func (m Model) viewMemberDetail() string {
+	var b strings.Builder
+
+	fmt.Fprintln(&b, titleStyle.Render(fmt.Sprintf("%s – per-day breakdown", m.detailName)))
+	fmt.Fprintln(&b)
+
+	var table strings.Builder
+	fmt.Fprintln(&table, tableHeaderRowStyle.Render(
+		fmt.Sprintf("%5s   %5s   %-10s   %-10s", "Day", "Pts", "P1", "P2"),
+	))
+	fmt.Fprintln(&table)
+
+	for _, e := range m.detailEntries {
+		p1, p2 := "-", "-"
+		if e.HasPart1 {
+			p1 = formatDuration(e.Part1Since)
+		}
+		if e.HasPart2 {
+			p2 = formatDuration(e.Part2Since)
+		}
+		line := fmt.Sprintf("%5d   %5d   %-10s   %-10s", e.Day, e.DayScore, p1, p2)
+		fmt.Fprintln(&table, tableRowStyle.Render(line))
+	}
+
+	fmt.Fprintln(&b, tableBoxStyle.Render(strings.TrimRight(table.String(), "\n")))
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, helpStyle.Render("Esc/Enter back to overall standings · q quit"))
+
+	return b.String()
+}
+
+// boardIDOrDash returns the active board's ID, or "-" if none is active.
+func boardIDOrDash(cfg config.Config) string {
+	if board, ok := cfg.ActiveBoard(); ok {
+		return board.BoardID
+	}
+	return "-"
+}
+
+// submitResultLabel renders a SubmitResult for display.
+func submitResultLabel(r aoc.SubmitResult) string {
+	switch r {
+	case aoc.SubmitCorrect:
+		return "Correct! ⭐"
+	case aoc.SubmitIncorrect:
+		return "Incorrect"
+	case aoc.SubmitWait:
+		return "Too soon, please wait"
+	case aoc.SubmitAlreadyDone:
+		return "Already solved"
+	case aoc.SubmitWrongLevel:
+		return "Wrong level (already solved, or part 1 not done yet)"
+	default:
+		return "Unknown response from AoC"
+	}
+}
+
 // formatPosition renders AoC-style positions (" 1)", "13)", etc.).
 func formatPosition(pos int) string {
 	return fmt.Sprintf("%2d)", pos)
@@ -442,7 +1369,7 @@ func formatDuration(d time.Duration) string {
 	return fmt.Sprintf("%02d:%02d:%02d", h, m, s)
 }
 
-// truncate shortens a string to max runes and appends â€¦ if needed.
+// truncate shortens a string to max runes and appends … if needed.
 func truncate(s string, max int) string {
 	runes := []rune(s)
 	if len(runes) <= max {
@@ -451,26 +1378,5 @@ func truncate(s string, max int) string {
 	if max <= 1 {
 		return string(runes[:max])
 	}
-	return string(runes[:max-1]) + "â€¦"
-}
-
-// validateLeaderboardURL ensures the AoC URL is present and contains a view_key.
-func validateLeaderboardURL(raw string) error {
-	if strings.TrimSpace(raw) == "" {
-		return errors.New("URL cannot be empty")
-	}
-	u, err := url.Parse(raw)
-	if err != nil {
-		return fmt.Errorf("invalid URL: %w", err)
-	}
-	if u.Scheme != "https" && u.Scheme != "http" {
-		return errors.New("URL must start with http or https")
-	}
-	if !strings.HasSuffix(u.Path, ".json") || !strings.Contains(u.Path, "/leaderboard/private/view/") {
-		return errors.New("URL should be the private leaderboard JSON link (â€¦/leaderboard/private/view/<id>.json)")
-	}
-	if v := u.Query().Get("view_key"); strings.TrimSpace(v) == "" {
-		return errors.New("URL must include ?view_key=<value>")
-	}
-	return nil
+	return string(runes[:max-1]) + "…"
 }